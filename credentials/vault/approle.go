@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+var _ CredentialProvider = (*AppRoleCredentialProvider)(nil)
+
+// AppRoleCredentialProvider supplies the credentials needed for Vault's
+// AppRole auth method: the static RoleID from authObj plus a SecretID read
+// from whichever of FilePath, Env, or SecretRef is configured.
+type AppRoleCredentialProvider struct {
+	authObj           *secretsv1beta1.VaultAuth
+	providerNamespace string
+	uid               types.UID
+}
+
+func NewAppRoleCredentialProvider(authObj *secretsv1beta1.VaultAuth, providerNamespace string,
+	uid types.UID,
+) *AppRoleCredentialProvider {
+	return &AppRoleCredentialProvider{
+		authObj:           authObj,
+		providerNamespace: providerNamespace,
+		uid:               uid,
+	}
+}
+
+func (a *AppRoleCredentialProvider) GetNamespace() string {
+	return a.providerNamespace
+}
+
+func (a *AppRoleCredentialProvider) GetUID() types.UID {
+	return a.uid
+}
+
+func (a *AppRoleCredentialProvider) Init(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string) error {
+	if authObj.Spec.AppRole == nil {
+		return fmt.Errorf("approle auth method not configured")
+	}
+	if authObj.Spec.AppRole.RoleID == "" {
+		return fmt.Errorf("invalid approle auth configuration: role_id is required")
+	}
+
+	a.authObj = authObj
+	a.providerNamespace = providerNamespace
+
+	// Read the SecretID up front to validate the configuration.
+	if _, err := a.readSecretID(ctx, client); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// readSecretID resolves the AppRole SecretID from whichever source is set
+// on authObj.Spec.AppRole: FilePath, Env, or SecretRef.
+func (a *AppRoleCredentialProvider) readSecretID(ctx context.Context, client ctrlclient.Client) (string, error) {
+	cfg := a.authObj.Spec.AppRole
+	return resolveCredentialSource(ctx, client, a.authObj, a.providerNamespace, "approle secret_id", CredentialSource{
+		FilePath:  cfg.FilePath,
+		Env:       cfg.Env,
+		SecretRef: cfg.SecretRef,
+	})
+}
+
+func (a *AppRoleCredentialProvider) GetCreds(ctx context.Context, client ctrlclient.Client) (map[string]interface{}, error) {
+	logger := log.FromContext(ctx)
+
+	secretID, err := a.readSecretID(ctx, client)
+	if err != nil {
+		logger.Error(err, "Failed to get approle secret_id")
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"role_id":   a.authObj.Spec.AppRole.RoleID,
+		"secret_id": secretID,
+	}, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+func TestAppRoleCredentialProvider_Init(t *testing.T) {
+	ctx := context.Background()
+	providerNamespace := "test-provider-namespace"
+
+	tempDir, err := os.MkdirTemp("", "approle-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	secretIDFile := filepath.Join(tempDir, "secret-id")
+	require.NoError(t, os.WriteFile(secretIDFile, []byte("my-secret-id"), 0600))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	tests := map[string]struct {
+		authObj     *secretsv1beta1.VaultAuth
+		expectedErr string
+	}{
+		"success": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method: "approle",
+					AppRole: &secretsv1beta1.VaultAuthConfigAppRole{
+						RoleID:   "my-role-id",
+						FilePath: secretIDFile,
+					},
+				},
+			},
+		},
+		"missing approle config": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{Method: "approle"},
+			},
+			expectedErr: "approle auth method not configured",
+		},
+		"missing role_id": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method:  "approle",
+					AppRole: &secretsv1beta1.VaultAuthConfigAppRole{FilePath: secretIDFile},
+				},
+			},
+			expectedErr: "role_id is required",
+		},
+		"no secret_id source configured": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method:  "approle",
+					AppRole: &secretsv1beta1.VaultAuthConfigAppRole{RoleID: "my-role-id"},
+				},
+			},
+			expectedErr: "no approle secret_id source configured",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider := &AppRoleCredentialProvider{}
+			err := provider.Init(ctx, fakeClient, tc.authObj, providerNamespace)
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, providerNamespace, provider.GetNamespace())
+			}
+		})
+	}
+}
+
+func TestAppRoleCredentialProvider_GetCreds(t *testing.T) {
+	ctx := context.Background()
+	providerNamespace := "test-provider-namespace"
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "approle-secret-id", Namespace: providerNamespace},
+		Data:       map[string][]byte{"secret_id": []byte("secret-id-from-secret")},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	provider := &AppRoleCredentialProvider{
+		authObj: &secretsv1beta1.VaultAuth{
+			Spec: secretsv1beta1.VaultAuthSpec{
+				AppRole: &secretsv1beta1.VaultAuthConfigAppRole{
+					RoleID: "my-role-id",
+					SecretRef: &secretsv1beta1.VaultSecretReference{
+						Name: "approle-secret-id",
+						Key:  "secret_id",
+					},
+				},
+			},
+		},
+		providerNamespace: providerNamespace,
+	}
+
+	creds, err := provider.GetCreds(ctx, fakeClient)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"role_id":   "my-role-id",
+		"secret_id": "secret-id-from-secret",
+	}, creds)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+// CredentialSource describes the possible places a credential value (a
+// Token auth token, an AppRole SecretID, a JWT, a Kubernetes auth service
+// account token, etc.) can be read from. Exactly one of FilePath, Env, or
+// SecretRef is expected to be set; enforcing that is the responsibility of
+// each auth method's own Validate(), not of resolveCredentialSource.
+//
+// TokenCredentialProvider (token.go), AppRoleCredentialProvider (approle.go),
+// JWTCredentialProvider (jwt.go), and KubernetesCredentialProvider
+// (kubernetes.go) all wire their dynamic credential through this.
+type CredentialSource struct {
+	FilePath  string
+	Env       string
+	SecretRef *secretsv1beta1.VaultSecretReference
+}
+
+// resolveCredentialSource reads a credential value from whichever of src's
+// fields is set. label is used only to produce readable error messages
+// (e.g. "token", "approle secret_id", "jwt").
+func resolveCredentialSource(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string, label string, src CredentialSource) (string, error) {
+	switch {
+	case src.FilePath != "":
+		return resolveFromFile(src.FilePath, label)
+	case src.Env != "":
+		return resolveFromEnv(src.Env, label)
+	case src.SecretRef != nil:
+		return resolveFromSecret(ctx, client, authObj, providerNamespace, src.SecretRef, label)
+	default:
+		return "", fmt.Errorf("no %s source configured", label)
+	}
+}
+
+func resolveFromFile(filePath, label string) (string, error) {
+	if filePath == "" {
+		return "", fmt.Errorf("file path is empty")
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s file %s: %w", label, filePath, err)
+	}
+
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return "", fmt.Errorf("%s file %s is empty or contains only whitespace", label, filePath)
+	}
+
+	return value, nil
+}
+
+func resolveFromEnv(name, label string) (string, error) {
+	val, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("env var %s is not set", name)
+	}
+
+	value := strings.TrimSpace(val)
+	if value == "" {
+		return "", fmt.Errorf("env var %s is empty or contains only whitespace", name)
+	}
+
+	return value, nil
+}
+
+// resolveFromSecret reads a credential from the Kubernetes Secret referenced
+// by ref. Cross-namespace references are only honored when the target
+// namespace is in authObj's AllowedNamespaces list.
+func resolveFromSecret(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string, ref *secretsv1beta1.VaultSecretReference, label string) (string, error) {
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = providerNamespace
+	}
+	if namespace != providerNamespace && !isNamespaceAllowed(authObj, namespace) {
+		return "", fmt.Errorf("secretRef namespace %s is not in the allowed namespaces for %s", namespace, authObj.Name)
+	}
+
+	key := ctrlclient.ObjectKey{Namespace: namespace, Name: ref.Name}
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to read %s secret %s: %w", label, key, err)
+	}
+
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s does not contain key %q", key, ref.Key)
+	}
+
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return "", fmt.Errorf("secret %s key %q is empty or contains only whitespace", key, ref.Key)
+	}
+
+	return value, nil
+}
+
+func isNamespaceAllowed(authObj *secretsv1beta1.VaultAuth, namespace string) bool {
+	for _, allowed := range authObj.Spec.AllowedNamespaces {
+		if allowed == "*" || allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+func TestResolveFromFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "credential-source-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	tests := map[string]struct {
+		fileContent   string
+		expectedValue string
+		expectedErr   string
+	}{
+		"valid value": {
+			fileContent:   "my-secret-id",
+			expectedValue: "my-secret-id",
+		},
+		"value with whitespace": {
+			fileContent:   "  my-secret-id\n  ",
+			expectedValue: "my-secret-id",
+		},
+		"empty file": {
+			fileContent: "",
+			expectedErr: "is empty or contains only whitespace",
+		},
+		"whitespace only": {
+			fileContent: "   \n\t  ",
+			expectedErr: "is empty or contains only whitespace",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			testFile := filepath.Join(tempDir, name+"-value")
+			require.NoError(t, os.WriteFile(testFile, []byte(tc.fileContent), 0600))
+
+			value, err := resolveFromFile(testFile, "approle secret_id")
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedValue, value)
+			}
+		})
+	}
+
+	t.Run("empty file path", func(t *testing.T) {
+		value, err := resolveFromFile("", "approle secret_id")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "file path is empty")
+		assert.Empty(t, value)
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		value, err := resolveFromFile("/non/existent/path", "approle secret_id")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read approle secret_id file /non/existent/path")
+		assert.Empty(t, value)
+	})
+}
+
+func TestResolveFromEnv(t *testing.T) {
+	tests := map[string]struct {
+		envValue      string
+		envSet        bool
+		expectedValue string
+		expectedErr   string
+	}{
+		"valid value": {
+			envSet:        true,
+			envValue:      "my-jwt",
+			expectedValue: "my-jwt",
+		},
+		"value with whitespace": {
+			envSet:        true,
+			envValue:      "  my-jwt\n  ",
+			expectedValue: "my-jwt",
+		},
+		"unset": {
+			envSet:      false,
+			expectedErr: "is not set",
+		},
+		"empty": {
+			envSet:      true,
+			envValue:    "   ",
+			expectedErr: "is empty or contains only whitespace",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			const envName = "VSO_CREDENTIAL_SOURCE_TEST"
+			if tc.envSet {
+				t.Setenv(envName, tc.envValue)
+			}
+
+			value, err := resolveFromEnv(envName, "jwt")
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestResolveFromSecret(t *testing.T) {
+	ctx := context.Background()
+	providerNamespace := "test-provider-namespace"
+	otherNamespace := "other-namespace"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: providerNamespace},
+		Data:       map[string][]byte{"jwt": []byte("  my-jwt\n  "), "empty": []byte("")},
+	}
+	crossNamespaceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: otherNamespace},
+		Data:       map[string][]byte{"jwt": []byte("cross-namespace-jwt")},
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(secret, crossNamespaceSecret).
+		Build()
+
+	tests := map[string]struct {
+		authObj       *secretsv1beta1.VaultAuth
+		ref           *secretsv1beta1.VaultSecretReference
+		expectedValue string
+		expectedErr   string
+	}{
+		"same namespace": {
+			authObj:       &secretsv1beta1.VaultAuth{},
+			ref:           &secretsv1beta1.VaultSecretReference{Name: "creds", Key: "jwt"},
+			expectedValue: "my-jwt",
+		},
+		"missing key": {
+			authObj:     &secretsv1beta1.VaultAuth{},
+			ref:         &secretsv1beta1.VaultSecretReference{Name: "creds", Key: "missing"},
+			expectedErr: "does not contain key",
+		},
+		"empty key": {
+			authObj:     &secretsv1beta1.VaultAuth{},
+			ref:         &secretsv1beta1.VaultSecretReference{Name: "creds", Key: "empty"},
+			expectedErr: "is empty or contains only whitespace",
+		},
+		"secret not found": {
+			authObj:     &secretsv1beta1.VaultAuth{},
+			ref:         &secretsv1beta1.VaultSecretReference{Name: "missing", Key: "jwt"},
+			expectedErr: "failed to read jwt secret",
+		},
+		"disallowed cross namespace": {
+			authObj:     &secretsv1beta1.VaultAuth{ObjectMeta: metav1.ObjectMeta{Name: "my-auth"}},
+			ref:         &secretsv1beta1.VaultSecretReference{Namespace: otherNamespace, Name: "creds", Key: "jwt"},
+			expectedErr: "secretRef namespace other-namespace is not in the allowed namespaces for my-auth",
+		},
+		"allowed cross namespace": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{AllowedNamespaces: []string{otherNamespace}},
+			},
+			ref:           &secretsv1beta1.VaultSecretReference{Namespace: otherNamespace, Name: "creds", Key: "jwt"},
+			expectedValue: "cross-namespace-jwt",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, err := resolveFromSecret(ctx, fakeClient, tc.authObj, providerNamespace, tc.ref, "jwt")
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestResolveCredentialSource_NoSourceConfigured(t *testing.T) {
+	_, err := resolveCredentialSource(context.Background(), nil, &secretsv1beta1.VaultAuth{}, "ns", "jwt", CredentialSource{})
+	require.Error(t, err)
+	assert.Equal(t, "no jwt source configured", err.Error())
+}
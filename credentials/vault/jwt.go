@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+var _ CredentialProvider = (*JWTCredentialProvider)(nil)
+
+// JWTCredentialProvider supplies the credentials needed for Vault's JWT auth
+// method: the JWT itself, read from whichever of FilePath, Env, or SecretRef
+// is configured on authObj.Spec.JWT.
+type JWTCredentialProvider struct {
+	authObj           *secretsv1beta1.VaultAuth
+	providerNamespace string
+	uid               types.UID
+}
+
+func NewJWTCredentialProvider(authObj *secretsv1beta1.VaultAuth, providerNamespace string,
+	uid types.UID,
+) *JWTCredentialProvider {
+	return &JWTCredentialProvider{
+		authObj:           authObj,
+		providerNamespace: providerNamespace,
+		uid:               uid,
+	}
+}
+
+func (j *JWTCredentialProvider) GetNamespace() string {
+	return j.providerNamespace
+}
+
+func (j *JWTCredentialProvider) GetUID() types.UID {
+	return j.uid
+}
+
+func (j *JWTCredentialProvider) Init(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string) error {
+	if authObj.Spec.JWT == nil {
+		return fmt.Errorf("jwt auth method not configured")
+	}
+
+	j.authObj = authObj
+	j.providerNamespace = providerNamespace
+
+	// Read the JWT up front to validate the configuration.
+	if _, err := j.readJWT(ctx, client); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// readJWT resolves the configured JWT from whichever source is set on
+// authObj.Spec.JWT: FilePath, Env, or SecretRef.
+func (j *JWTCredentialProvider) readJWT(ctx context.Context, client ctrlclient.Client) (string, error) {
+	cfg := j.authObj.Spec.JWT
+	return resolveCredentialSource(ctx, client, j.authObj, j.providerNamespace, "jwt", CredentialSource{
+		FilePath:  cfg.FilePath,
+		Env:       cfg.Env,
+		SecretRef: cfg.SecretRef,
+	})
+}
+
+func (j *JWTCredentialProvider) GetCreds(ctx context.Context, client ctrlclient.Client) (map[string]interface{}, error) {
+	logger := log.FromContext(ctx)
+
+	jwt, err := j.readJWT(ctx, client)
+	if err != nil {
+		logger.Error(err, "Failed to get jwt")
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"jwt": jwt,
+	}, nil
+}
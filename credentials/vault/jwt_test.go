@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+func TestJWTCredentialProvider_Init(t *testing.T) {
+	ctx := context.Background()
+	providerNamespace := "test-provider-namespace"
+
+	tempDir, err := os.MkdirTemp("", "jwt-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	jwtFile := filepath.Join(tempDir, "jwt")
+	require.NoError(t, os.WriteFile(jwtFile, []byte("my-jwt"), 0600))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	tests := map[string]struct {
+		authObj     *secretsv1beta1.VaultAuth
+		expectedErr string
+	}{
+		"success": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method: "jwt",
+					JWT:    &secretsv1beta1.VaultAuthConfigJWT{FilePath: jwtFile},
+				},
+			},
+		},
+		"missing jwt config": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{Method: "jwt"},
+			},
+			expectedErr: "jwt auth method not configured",
+		},
+		"no source configured": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method: "jwt",
+					JWT:    &secretsv1beta1.VaultAuthConfigJWT{},
+				},
+			},
+			expectedErr: "no jwt source configured",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider := &JWTCredentialProvider{}
+			err := provider.Init(ctx, fakeClient, tc.authObj, providerNamespace)
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, providerNamespace, provider.GetNamespace())
+			}
+		})
+	}
+}
+
+func TestJWTCredentialProvider_GetCreds(t *testing.T) {
+	ctx := context.Background()
+
+	tempDir, err := os.MkdirTemp("", "jwt-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	jwtFile := filepath.Join(tempDir, "jwt")
+	require.NoError(t, os.WriteFile(jwtFile, []byte("my-jwt"), 0600))
+
+	provider := &JWTCredentialProvider{
+		authObj: &secretsv1beta1.VaultAuth{
+			Spec: secretsv1beta1.VaultAuthSpec{
+				JWT: &secretsv1beta1.VaultAuthConfigJWT{FilePath: jwtFile},
+			},
+		},
+	}
+
+	creds, err := provider.GetCreds(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"jwt": "my-jwt"}, creds)
+}
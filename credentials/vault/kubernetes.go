@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+var _ CredentialProvider = (*KubernetesCredentialProvider)(nil)
+
+// KubernetesCredentialProvider supplies the credentials needed for Vault's
+// Kubernetes auth method: the service account JWT, read from whichever of
+// FilePath, Env, or SecretRef is configured on authObj.Spec.Kubernetes.
+// FilePath is expected to be the usual choice, pointing at an in-cluster or
+// projected service account token file; Env and SecretRef exist for parity
+// with the other auth methods' credential sourcing.
+type KubernetesCredentialProvider struct {
+	authObj           *secretsv1beta1.VaultAuth
+	providerNamespace string
+	uid               types.UID
+}
+
+func NewKubernetesCredentialProvider(authObj *secretsv1beta1.VaultAuth, providerNamespace string,
+	uid types.UID,
+) *KubernetesCredentialProvider {
+	return &KubernetesCredentialProvider{
+		authObj:           authObj,
+		providerNamespace: providerNamespace,
+		uid:               uid,
+	}
+}
+
+func (k *KubernetesCredentialProvider) GetNamespace() string {
+	return k.providerNamespace
+}
+
+func (k *KubernetesCredentialProvider) GetUID() types.UID {
+	return k.uid
+}
+
+func (k *KubernetesCredentialProvider) Init(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string) error {
+	if authObj.Spec.Kubernetes == nil {
+		return fmt.Errorf("kubernetes auth method not configured")
+	}
+
+	k.authObj = authObj
+	k.providerNamespace = providerNamespace
+
+	// Read the service account token up front to validate the configuration.
+	if _, err := k.readServiceAccountToken(ctx, client); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// readServiceAccountToken resolves the configured service account token
+// from whichever source is set on authObj.Spec.Kubernetes: FilePath, Env, or
+// SecretRef.
+func (k *KubernetesCredentialProvider) readServiceAccountToken(ctx context.Context, client ctrlclient.Client) (string, error) {
+	cfg := k.authObj.Spec.Kubernetes
+	return resolveCredentialSource(ctx, client, k.authObj, k.providerNamespace, "kubernetes service account token", CredentialSource{
+		FilePath:  cfg.FilePath,
+		Env:       cfg.Env,
+		SecretRef: cfg.SecretRef,
+	})
+}
+
+func (k *KubernetesCredentialProvider) GetCreds(ctx context.Context, client ctrlclient.Client) (map[string]interface{}, error) {
+	logger := log.FromContext(ctx)
+
+	jwt, err := k.readServiceAccountToken(ctx, client)
+	if err != nil {
+		logger.Error(err, "Failed to get kubernetes service account token")
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"jwt": jwt,
+	}, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
+)
+
+func TestKubernetesCredentialProvider_Init(t *testing.T) {
+	ctx := context.Background()
+	providerNamespace := "test-provider-namespace"
+
+	tempDir, err := os.MkdirTemp("", "kubernetes-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	tokenFile := filepath.Join(tempDir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("my-sa-token"), 0600))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	tests := map[string]struct {
+		authObj     *secretsv1beta1.VaultAuth
+		expectedErr string
+	}{
+		"success": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method:     "kubernetes",
+					Kubernetes: &secretsv1beta1.VaultAuthConfigKubernetes{FilePath: tokenFile},
+				},
+			},
+		},
+		"missing kubernetes config": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{Method: "kubernetes"},
+			},
+			expectedErr: "kubernetes auth method not configured",
+		},
+		"no source configured": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method:     "kubernetes",
+					Kubernetes: &secretsv1beta1.VaultAuthConfigKubernetes{},
+				},
+			},
+			expectedErr: "no kubernetes service account token source configured",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			provider := &KubernetesCredentialProvider{}
+			err := provider.Init(ctx, fakeClient, tc.authObj, providerNamespace)
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, providerNamespace, provider.GetNamespace())
+			}
+		})
+	}
+}
+
+func TestKubernetesCredentialProvider_GetCreds(t *testing.T) {
+	ctx := context.Background()
+
+	tempDir, err := os.MkdirTemp("", "kubernetes-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	tokenFile := filepath.Join(tempDir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("my-sa-token"), 0600))
+
+	provider := &KubernetesCredentialProvider{
+		authObj: &secretsv1beta1.VaultAuth{
+			Spec: secretsv1beta1.VaultAuthSpec{
+				Kubernetes: &secretsv1beta1.VaultAuthConfigKubernetes{FilePath: tokenFile},
+			},
+		},
+	}
+
+	creds, err := provider.GetCreds(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"jwt": "my-sa-token"}, creds)
+}
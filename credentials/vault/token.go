@@ -5,32 +5,144 @@ package vault
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
-	"strings"
+	"path/filepath"
+	"sync"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-logr/logr"
+	vaultapi "github.com/hashicorp/vault/api"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
 )
 
+// tokenFileWatchDebounce coalesces the burst of fsnotify events a single
+// atomic-rename replacement (kubelet projected volumes, Vault Agent's
+// "atomic write" sink) tends to generate into one re-read.
+const tokenFileWatchDebounce = 250 * time.Millisecond
+
+// tokenRenewCheckInterval is how often the renewal loop checks the token's
+// remaining TTL. tokenRenewMaxInterval caps the backoff applied after
+// transient lookup/renew errors.
+const (
+	tokenRenewCheckInterval = 30 * time.Second
+	tokenRenewMaxInterval   = 5 * time.Minute
+)
+
+// tokenSecretPollInterval governs how often a SecretRef-sourced token is
+// polled for rotation. TokenCredentialProvider has no access to the
+// manager's shared informer cache, so it cannot register a native watch on
+// an arbitrary Secret the way it watches a FilePath with fsnotify; polling
+// is the pragmatic stand-in until that wiring moves up into the VaultAuth
+// controller.
+const tokenSecretPollInterval = 30 * time.Second
+
+// ErrUnwrapToken is returned when a configured response-wrapping token could
+// not be unwrapped. It is distinct from the errors readTokenFile returns so
+// that callers can tell "the token file is missing/unreadable" apart from
+// "the wrapping token was already consumed or has expired".
+var ErrUnwrapToken = errors.New("failed to unwrap wrapping token")
+
+// newVaultClient builds the low-level Vault API client used to unwrap
+// response-wrapping tokens and to look up/renew the plain token. It applies
+// the address and TLS settings of the VaultConnection authObj references
+// (the same config the rest of VSO uses to reach Vault) on top of the
+// ambient-env-var defaults, so this client talks to the same Vault instance
+// with the same trust as everything else. It is a package-level var so that
+// tests can point it at a fake Vault server.
+var newVaultClient = func(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string) (*vaultapi.Client, error) {
+	cfg := vaultapi.DefaultConfig()
+
+	if client != nil && authObj != nil && authObj.Spec.VaultConnectionRef != "" {
+		if err := applyVaultConnectionConfig(ctx, client, authObj, providerNamespace, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	return vaultapi.NewClient(cfg)
+}
+
+// applyVaultConnectionConfig resolves the VaultConnection referenced by
+// authObj and layers its address and TLS settings onto cfg.
+func applyVaultConnectionConfig(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string, cfg *vaultapi.Config) error {
+	key := ctrlclient.ObjectKey{Namespace: providerNamespace, Name: authObj.Spec.VaultConnectionRef}
+	conn := &secretsv1beta1.VaultConnection{}
+	if err := client.Get(ctx, key, conn); err != nil {
+		return fmt.Errorf("failed to read VaultConnection %s: %w", key, err)
+	}
+
+	if conn.Spec.Address != "" {
+		cfg.Address = conn.Spec.Address
+	}
+
+	switch {
+	case conn.Spec.CACertSecretRef != "":
+		caSecret := &corev1.Secret{}
+		caKey := ctrlclient.ObjectKey{Namespace: providerNamespace, Name: conn.Spec.CACertSecretRef}
+		if err := client.Get(ctx, caKey, caSecret); err != nil {
+			return fmt.Errorf("failed to read VaultConnection CA cert secret %s: %w", caKey, err)
+		}
+		caBytes, ok := caSecret.Data["ca.crt"]
+		if !ok {
+			return fmt.Errorf("CA cert secret %s does not contain key %q", caKey, "ca.crt")
+		}
+		if err := cfg.ConfigureTLS(&vaultapi.TLSConfig{CACertBytes: caBytes}); err != nil {
+			return fmt.Errorf("failed to configure Vault client TLS: %w", err)
+		}
+	case conn.Spec.SkipTLSVerify:
+		if err := cfg.ConfigureTLS(&vaultapi.TLSConfig{Insecure: true}); err != nil {
+			return fmt.Errorf("failed to configure Vault client TLS: %w", err)
+		}
+	}
+
+	return nil
+}
+
 var _ CredentialProvider = (*TokenCredentialProvider)(nil)
 
 type TokenCredentialProvider struct {
 	authObj           *secretsv1beta1.VaultAuth
 	providerNamespace string
-	uid               types.UID
+
+	// k8sClient is the client passed to Init. It is retained so that the
+	// background secret-poll loop and the Vault API client builder, both of
+	// which run outside the request-scoped calls that normally carry a
+	// client, can still read Secrets and resolve the VaultConnection.
+	k8sClient ctrlclient.Client
+
+	mu  sync.Mutex
+	uid types.UID
+
+	// OnTokenRotated, when set, is called after the watched token file is
+	// rewritten and the provider's UID has been recomputed from its new
+	// content. The VaultAuth controller wires this to invalidate the
+	// ClientCacheStorage entry for the previous UID and requeue dependent
+	// resources.
+	OnTokenRotated func(ctx context.Context, oldUID, newUID types.UID)
+
+	// Recorder, when set, receives Kubernetes events on renewal failures.
+	Recorder record.EventRecorder
+
+	watchCancel context.CancelFunc
+	renewCancel context.CancelFunc
 }
 
 func NewTokenCredentialProvider(authObj *secretsv1beta1.VaultAuth, providerNamespace string,
 	uid types.UID,
 ) *TokenCredentialProvider {
 	return &TokenCredentialProvider{
-		authObj,
-		providerNamespace,
-		uid,
+		authObj:           authObj,
+		providerNamespace: providerNamespace,
+		uid:               uid,
 	}
 }
 
@@ -39,9 +151,27 @@ func (t *TokenCredentialProvider) GetNamespace() string {
 }
 
 func (t *TokenCredentialProvider) GetUID() types.UID {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	return t.uid
 }
 
+// setUID updates the provider's UID, returning the previous value.
+func (t *TokenCredentialProvider) setUID(uid types.UID) types.UID {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	old := t.uid
+	t.uid = uid
+	return old
+}
+
+// tokenUID derives a stable UID from the token content so that cache
+// lookups keyed on it actually change when the underlying token rotates.
+func tokenUID(token string) types.UID {
+	sum := sha256.Sum256([]byte(token))
+	return types.UID(fmt.Sprintf("token-file-provider-%x", sum[:8]))
+}
+
 func (t *TokenCredentialProvider) Init(ctx context.Context, client ctrlclient.Client, authObj *secretsv1beta1.VaultAuth, providerNamespace string) error {
 	if authObj.Spec.Token == nil {
 		return fmt.Errorf("token auth method not configured")
@@ -52,44 +182,382 @@ func (t *TokenCredentialProvider) Init(ctx context.Context, client ctrlclient.Cl
 
 	t.authObj = authObj
 	t.providerNamespace = providerNamespace
+	t.k8sClient = client
 
-	// Try to read the file to validate it exists and is readable
-	_, err := t.readTokenFile()
+	// Try to read (and, if configured, unwrap) the token to validate the
+	// configuration up front.
+	token, err := t.getToken(ctx, client)
 	if err != nil {
 		return err
 	}
 
-	// Set a static UID since we're not tied to a Kubernetes resource
-	t.uid = types.UID("token-file-provider")
+	// UID is derived from the token content (rather than a static value) so
+	// that cache lookups keyed on it change whenever the token rotates.
+	t.setUID(tokenUID(token))
+
+	switch {
+	case authObj.Spec.Token.FilePath != "":
+		if err := t.startWatch(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to start token file watcher; token rotation will not be detected until the next full reconcile")
+		}
+
+		if renew := authObj.Spec.Token.Renew; renew != nil && renew.Enabled {
+			t.startRenewalLoop(ctx, renew)
+		}
+	case authObj.Spec.Token.SecretRef != nil:
+		t.startSecretWatch(ctx)
+	}
 
 	return nil
 }
 
-func (t *TokenCredentialProvider) readTokenFile() (string, error) {
+// startWatch watches the parent directory of the token file for changes.
+// Watching the directory rather than the file itself is required to survive
+// atomic-rename replacements, which kubelet's projected volumes and Vault
+// Agent's "atomic write" sink both use instead of rewriting the file in
+// place.
+func (t *TokenCredentialProvider) startWatch(ctx context.Context) error {
 	filePath := t.authObj.Spec.Token.FilePath
-	if filePath == "" {
-		return "", fmt.Errorf("file path is empty")
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create token file watcher: %w", err)
+	}
+
+	dir := filepath.Dir(filePath)
+	if err := watcher.Add(dir); err != nil {
+		_ = watcher.Close()
+		return fmt.Errorf("failed to watch directory %s: %w", dir, err)
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	t.watchCancel = cancel
+
+	go t.watchLoop(watchCtx, watcher)
+
+	return nil
+}
+
+// Close stops the token file watcher and renewal loop, if running. It
+// should be called from the manager's shutdown path.
+func (t *TokenCredentialProvider) Close() {
+	if t.watchCancel != nil {
+		t.watchCancel()
+	}
+	if t.renewCancel != nil {
+		t.renewCancel()
 	}
+}
+
+// startSecretWatch starts a goroutine that polls the configured SecretRef
+// for changes, as a stand-in for a real Secret watch (see
+// tokenSecretPollInterval).
+func (t *TokenCredentialProvider) startSecretWatch(ctx context.Context) {
+	watchCtx, cancel := context.WithCancel(ctx)
+	t.watchCancel = cancel
 
-	data, err := os.ReadFile(filePath)
+	go t.secretWatchLoop(watchCtx)
+}
+
+func (t *TokenCredentialProvider) secretWatchLoop(ctx context.Context) {
+	logger := log.FromContext(ctx)
+
+	ticker := time.NewTicker(tokenSecretPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.handleSecretPoll(ctx, logger)
+		}
+	}
+}
+
+// handleSecretPoll re-reads the configured token Secret and, if its content
+// has changed since the last observation, recomputes the provider's UID and
+// invokes OnTokenRotated, mirroring handleFileRotation's behavior for the
+// FilePath source.
+func (t *TokenCredentialProvider) handleSecretPoll(ctx context.Context, logger logr.Logger) {
+	token, err := resolveFromSecret(ctx, t.k8sClient, t.authObj, t.providerNamespace, t.authObj.Spec.Token.SecretRef, "token")
 	if err != nil {
-		return "", fmt.Errorf("failed to read token file %s: %w", filePath, err)
+		logger.Error(err, "Failed to poll token secret for rotation")
+		return
+	}
+	if t.authObj.Spec.Token.IsWrappingToken {
+		if token, err = t.unwrapToken(ctx, t.k8sClient, token); err != nil {
+			logger.Error(err, "Failed to unwrap rotated wrapping token")
+			return
+		}
 	}
 
-	token := strings.TrimSpace(string(data))
-	if token == "" {
-		return "", fmt.Errorf("token file %s is empty or contains only whitespace", filePath)
+	newUID := tokenUID(token)
+	oldUID := t.setUID(newUID)
+	if oldUID == newUID {
+		return
 	}
 
-	return token, nil
+	logger.Info("Token secret rotated", "oldUID", oldUID, "newUID", newUID)
+	if t.OnTokenRotated != nil {
+		t.OnTokenRotated(ctx, oldUID, newUID)
+	}
 }
 
-func (t *TokenCredentialProvider) GetCreds(ctx context.Context, client ctrlclient.Client) (map[string]interface{}, error) {
+// startRenewalLoop starts a goroutine that periodically renews the token
+// read from FilePath, for as long as it remains renewable. It stops
+// renewing (falling back to the file watcher for rotation) once lookup-self
+// reports the token as non-renewable, which is expected once a Vault
+// Agent-managed sink file is itself rotated for a fresh, non-renewed token.
+func (t *TokenCredentialProvider) startRenewalLoop(ctx context.Context, renew *secretsv1beta1.VaultTokenRenewSpec) {
+	renewCtx, cancel := context.WithCancel(ctx)
+	t.renewCancel = cancel
+
+	go t.renewLoop(renewCtx, renew)
+}
+
+func (t *TokenCredentialProvider) renewLoop(ctx context.Context, renew *secretsv1beta1.VaultTokenRenewSpec) {
 	logger := log.FromContext(ctx)
 
+	interval := tokenRenewCheckInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			var keepRenewing bool
+			interval, keepRenewing = t.renewTick(ctx, logger, renew, interval)
+			if !keepRenewing {
+				logger.Info("Token is not renewable; stopping the renewal loop and relying on the file watcher for rotation instead")
+				return
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// renewTick checks the token's remaining TTL and renews it if needed. It
+// returns the interval to wait before the next check (the base check
+// interval on success, or a backed-off interval after a transient error),
+// and whether renewLoop should keep ticking at all: false once lookup-self
+// reports the token as non-renewable, since repeatedly polling a token that
+// will never be renewed wastes a request against Vault every tick forever.
+func (t *TokenCredentialProvider) renewTick(ctx context.Context, logger logr.Logger, renew *secretsv1beta1.VaultTokenRenewSpec, currentInterval time.Duration) (time.Duration, bool) {
 	token, err := t.readTokenFile()
 	if err != nil {
-		logger.Error(err, "Failed to read token from file")
+		t.recordRenewFailure(logger, err, "TokenLookupFailed")
+		return nextRenewBackoff(currentInterval), true
+	}
+
+	c, err := newVaultClient(ctx, t.k8sClient, t.authObj, t.providerNamespace)
+	if err != nil {
+		t.recordRenewFailure(logger, err, "TokenLookupFailed")
+		return nextRenewBackoff(currentInterval), true
+	}
+	c.SetToken(token)
+
+	self, err := c.Auth().Token().LookupSelfWithContext(ctx)
+	if err != nil {
+		t.recordRenewFailure(logger, err, "TokenLookupFailed")
+		return nextRenewBackoff(currentInterval), true
+	}
+
+	renewable, _ := self.Data["renewable"].(bool)
+	if !renewable {
+		return 0, false
+	}
+
+	ttl, err := remainingTTL(self)
+	if err != nil {
+		t.recordRenewFailure(logger, err, "TokenLookupFailed")
+		return nextRenewBackoff(currentInterval), true
+	}
+	if ttl >= renew.MinTTL {
+		return tokenRenewCheckInterval, true
+	}
+
+	if _, err := c.Auth().Token().RenewSelfWithContext(ctx, int(renew.Increment.Seconds())); err != nil {
+		t.recordRenewFailure(logger, err, "TokenRenewFailed")
+		return nextRenewBackoff(currentInterval), true
+	}
+
+	logger.Info("Renewed Vault token", "increment", renew.Increment)
+	return tokenRenewCheckInterval, true
+}
+
+func remainingTTL(secret *vaultapi.Secret) (time.Duration, error) {
+	ttlRaw, ok := secret.Data["ttl"].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("lookup-self response did not contain a numeric ttl")
+	}
+
+	seconds, err := ttlRaw.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse ttl: %w", err)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+func nextRenewBackoff(current time.Duration) time.Duration {
+	next := current * 2
+	if next > tokenRenewMaxInterval {
+		return tokenRenewMaxInterval
+	}
+	return next
+}
+
+func (t *TokenCredentialProvider) recordRenewFailure(logger logr.Logger, err error, reason string) {
+	logger.Error(err, "Token renewal loop error", "reason", reason)
+	if t.Recorder != nil && t.authObj != nil {
+		t.Recorder.Event(t.authObj, corev1.EventTypeWarning, reason, err.Error())
+	}
+}
+
+// watchLoop reacts to any event in the watched directory, not just ones
+// whose Name matches the token file path. Vault Agent's "atomic write" sink
+// does rename/write directly onto the token path, so a name filter would
+// catch that, but kubelet's projected volumes never touch the token file
+// itself: FilePath is a symlink into a versioned "..data" directory, and
+// rotation is an atomic rename of the "..data" symlink, which fsnotify
+// reports under the "..data" name, not the token file's. Re-reading the
+// token file (which follows the symlink) on any qualifying directory event,
+// and only acting if its content actually changed, handles both patterns
+// without needing to special-case either.
+func (t *TokenCredentialProvider) watchLoop(ctx context.Context, watcher *fsnotify.Watcher) {
+	logger := log.FromContext(ctx)
+	defer func() { _ = watcher.Close() }()
+
+	var debounce *time.Timer
+	defer func() {
+		if debounce != nil {
+			debounce.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			if debounce != nil {
+				debounce.Stop()
+			}
+			debounce = time.AfterFunc(tokenFileWatchDebounce, func() {
+				t.handleFileRotation(ctx, logger)
+			})
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.Error(err, "Token file watcher error")
+		}
+	}
+}
+
+func (t *TokenCredentialProvider) handleFileRotation(ctx context.Context, logger logr.Logger) {
+	token, err := t.readTokenFile()
+	if err != nil {
+		logger.Error(err, "Failed to re-read rotated token file")
+		return
+	}
+	if t.authObj.Spec.Token.IsWrappingToken {
+		if token, err = t.unwrapToken(ctx, t.k8sClient, token); err != nil {
+			logger.Error(err, "Failed to unwrap rotated wrapping token")
+			return
+		}
+	}
+
+	newUID := tokenUID(token)
+	oldUID := t.setUID(newUID)
+	if oldUID == newUID {
+		return
+	}
+
+	logger.Info("Token file rotated", "oldUID", oldUID, "newUID", newUID)
+	if t.OnTokenRotated != nil {
+		t.OnTokenRotated(ctx, oldUID, newUID)
+	}
+}
+
+// readTokenFile reads the token straight from FilePath, bypassing the other
+// configured sources. It's used to re-read the file after a watched rotation
+// and to fetch the token for each renewal check.
+func (t *TokenCredentialProvider) readTokenFile() (string, error) {
+	return resolveFromFile(t.authObj.Spec.Token.FilePath, "token")
+}
+
+// readToken resolves the configured token from whichever source is set on
+// authObj.Spec.Token: FilePath, Env, or SecretRef. Init validates that
+// exactly one of these is set.
+func (t *TokenCredentialProvider) readToken(ctx context.Context, client ctrlclient.Client) (string, error) {
+	cfg := t.authObj.Spec.Token
+	return resolveCredentialSource(ctx, client, t.authObj, t.providerNamespace, "token", CredentialSource{
+		FilePath:  cfg.FilePath,
+		Env:       cfg.Env,
+		SecretRef: cfg.SecretRef,
+	})
+}
+
+// getToken reads the configured token and, if the provider is configured to
+// treat it as a Vault response-wrapping token, unwraps it before returning.
+func (t *TokenCredentialProvider) getToken(ctx context.Context, client ctrlclient.Client) (string, error) {
+	token, err := t.readToken(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	if !t.authObj.Spec.Token.IsWrappingToken {
+		return token, nil
+	}
+
+	return t.unwrapToken(ctx, client, token)
+}
+
+// unwrapToken exchanges a Vault response-wrapping token for the client token
+// it wraps via sys/wrapping/unwrap. It handles both auth wraps (e.g. a
+// wrapped login response) and KV wraps, where the inner token is returned as
+// a "token" field on the wrapped secret's data.
+func (t *TokenCredentialProvider) unwrapToken(ctx context.Context, client ctrlclient.Client, wrappingToken string) (string, error) {
+	c, err := newVaultClient(ctx, client, t.authObj, t.providerNamespace)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrUnwrapToken, err)
+	}
+	c.SetToken(wrappingToken)
+
+	secret, err := c.Logical().Unwrap("")
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrUnwrapToken, err)
+	}
+	if secret == nil {
+		return "", fmt.Errorf("%w: empty unwrap response", ErrUnwrapToken)
+	}
+
+	if secret.Auth != nil && secret.Auth.ClientToken != "" {
+		return secret.Auth.ClientToken, nil
+	}
+	if tok, ok := secret.Data["token"].(string); ok && tok != "" {
+		return tok, nil
+	}
+
+	return "", fmt.Errorf("%w: unwrapped response did not contain a client token", ErrUnwrapToken)
+}
+
+func (t *TokenCredentialProvider) GetCreds(ctx context.Context, client ctrlclient.Client) (map[string]interface{}, error) {
+	logger := log.FromContext(ctx)
+
+	token, err := t.getToken(ctx, client)
+	if err != nil {
+		logger.Error(err, "Failed to get token")
 		return nil, err
 	}
 
@@ -5,20 +5,51 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr"
+	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	secretsv1beta1 "github.com/hashicorp/vault-secrets-operator/api/v1beta1"
 )
 
+// withFakeVaultUnwrapServer starts an httptest server that responds to
+// POST /v1/sys/wrapping/unwrap, points newVaultClient at it for the
+// duration of the test, and restores the original newVaultClient on
+// cleanup.
+func withFakeVaultUnwrapServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	orig := newVaultClient
+	newVaultClient = func(context.Context, ctrlclient.Client, *secretsv1beta1.VaultAuth, string) (*vaultapi.Client, error) {
+		cfg := vaultapi.DefaultConfig()
+		cfg.Address = server.URL
+		return vaultapi.NewClient(cfg)
+	}
+	t.Cleanup(func() { newVaultClient = orig })
+
+	return server
+}
+
 func TestTokenCredentialProvider_Init(t *testing.T) {
 	ctx := context.Background()
 	providerNamespace := "test-provider-namespace"
@@ -66,16 +97,14 @@ func TestTokenCredentialProvider_Init(t *testing.T) {
 			},
 			expectedErr: "token auth method not configured",
 		},
-		"invalid token config - empty filePath": {
+		"invalid token config - no source configured": {
 			authObj: &secretsv1beta1.VaultAuth{
 				Spec: secretsv1beta1.VaultAuthSpec{
 					Method: "token",
-					Token: &secretsv1beta1.VaultAuthConfigToken{
-						FilePath: "",
-					},
+					Token:  &secretsv1beta1.VaultAuthConfigToken{},
 				},
 			},
-			expectedErr: "invalid token auth configuration: empty filePath",
+			expectedErr: "invalid token auth configuration",
 		},
 		"file not found": {
 			authObj: &secretsv1beta1.VaultAuth{
@@ -120,6 +149,7 @@ func TestTokenCredentialProvider_Init(t *testing.T) {
 
 			provider := &TokenCredentialProvider{}
 			err := provider.Init(ctx, fakeClient, tc.authObj, providerNamespace)
+			defer provider.Close()
 
 			if tc.expectedErr != "" {
 				require.Error(t, err)
@@ -128,7 +158,7 @@ func TestTokenCredentialProvider_Init(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, tc.authObj, provider.authObj)
 				assert.Equal(t, providerNamespace, provider.providerNamespace)
-				assert.Equal(t, types.UID("token-file-provider"), provider.uid)
+				assert.Equal(t, tokenUID("vault-token-value"), provider.GetUID())
 			}
 		})
 	}
@@ -348,3 +378,566 @@ func TestTokenCredentialProvider_readTokenFile_EmptyFilePath(t *testing.T) {
 	assert.Contains(t, err.Error(), "file path is empty")
 	assert.Empty(t, token)
 }
+
+func TestTokenCredentialProvider_GetCreds_WrappingToken(t *testing.T) {
+	ctx := context.Background()
+
+	tempDir, err := os.MkdirTemp("", "token-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	wrappingTokenFile := filepath.Join(tempDir, "wrapping-token")
+	require.NoError(t, os.WriteFile(wrappingTokenFile, []byte("s.wrappingtoken"), 0600))
+
+	t.Run("auth wrap", func(t *testing.T) {
+		withFakeVaultUnwrapServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/sys/wrapping/unwrap", r.URL.Path)
+			assert.Equal(t, "s.wrappingtoken", r.Header.Get("X-Vault-Token"))
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"auth": map[string]interface{}{
+					"client_token": "s.unwrapped-client-token",
+				},
+			})
+		})
+
+		provider := &TokenCredentialProvider{
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method: "token",
+					Token: &secretsv1beta1.VaultAuthConfigToken{
+						FilePath:        wrappingTokenFile,
+						IsWrappingToken: true,
+					},
+				},
+			},
+		}
+
+		creds, err := provider.GetCreds(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"token": "s.unwrapped-client-token"}, creds)
+	})
+
+	t.Run("kv wrap", func(t *testing.T) {
+		withFakeVaultUnwrapServer(t, func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"token": "s.unwrapped-kv-token",
+				},
+			})
+		})
+
+		provider := &TokenCredentialProvider{
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method: "token",
+					Token: &secretsv1beta1.VaultAuthConfigToken{
+						FilePath:        wrappingTokenFile,
+						IsWrappingToken: true,
+					},
+				},
+			},
+		}
+
+		creds, err := provider.GetCreds(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"token": "s.unwrapped-kv-token"}, creds)
+	})
+
+	t.Run("unwrap already consumed", func(t *testing.T) {
+		withFakeVaultUnwrapServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []string{"wrapping token is not valid or does not exist"},
+			})
+		})
+
+		provider := &TokenCredentialProvider{
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method: "token",
+					Token: &secretsv1beta1.VaultAuthConfigToken{
+						FilePath:        wrappingTokenFile,
+						IsWrappingToken: true,
+					},
+				},
+			},
+		}
+
+		creds, err := provider.GetCreds(ctx, nil)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnwrapToken)
+		assert.Nil(t, creds)
+	})
+
+	t.Run("not a wrapping token leaves token unchanged", func(t *testing.T) {
+		provider := &TokenCredentialProvider{
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Method: "token",
+					Token: &secretsv1beta1.VaultAuthConfigToken{
+						FilePath: wrappingTokenFile,
+					},
+				},
+			},
+		}
+
+		creds, err := provider.GetCreds(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"token": "s.wrappingtoken"}, creds)
+	})
+}
+
+func TestTokenCredentialProvider_readToken(t *testing.T) {
+	ctx := context.Background()
+
+	tempDir, err := os.MkdirTemp("", "token-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	validTokenFile := filepath.Join(tempDir, "valid-token")
+	require.NoError(t, os.WriteFile(validTokenFile, []byte("file-token-value"), 0600))
+
+	providerNamespace := "test-provider-namespace"
+	otherNamespace := "other-namespace"
+
+	envSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: providerNamespace},
+		Data:       map[string][]byte{"token": []byte("  secret-token-value\n")},
+	}
+	crossNamespaceSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: otherNamespace},
+		Data:       map[string][]byte{"token": []byte("cross-namespace-token-value")},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	tests := map[string]struct {
+		authObj       *secretsv1beta1.VaultAuth
+		envVars       map[string]string
+		expectedToken string
+		expectedErr   string
+	}{
+		"file source": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Token: &secretsv1beta1.VaultAuthConfigToken{FilePath: validTokenFile},
+				},
+			},
+			expectedToken: "file-token-value",
+		},
+		"env source": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Token: &secretsv1beta1.VaultAuthConfigToken{Env: "VSO_TEST_TOKEN"},
+				},
+			},
+			envVars:       map[string]string{"VSO_TEST_TOKEN": "  env-token-value  "},
+			expectedToken: "env-token-value",
+		},
+		"env source unset": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Token: &secretsv1beta1.VaultAuthConfigToken{Env: "VSO_TEST_TOKEN_UNSET"},
+				},
+			},
+			expectedErr: "env var VSO_TEST_TOKEN_UNSET is not set",
+		},
+		"secretRef source, same namespace": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Token: &secretsv1beta1.VaultAuthConfigToken{
+						SecretRef: &secretsv1beta1.VaultSecretReference{Name: "vault-token", Key: "token"},
+					},
+				},
+			},
+			expectedToken: "secret-token-value",
+		},
+		"secretRef source, disallowed cross namespace": {
+			authObj: &secretsv1beta1.VaultAuth{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-auth"},
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Token: &secretsv1beta1.VaultAuthConfigToken{
+						SecretRef: &secretsv1beta1.VaultSecretReference{Namespace: otherNamespace, Name: "vault-token", Key: "token"},
+					},
+				},
+			},
+			expectedErr: "secretRef namespace other-namespace is not in the allowed namespaces for my-auth",
+		},
+		"secretRef source, allowed cross namespace": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					AllowedNamespaces: []string{otherNamespace},
+					Token: &secretsv1beta1.VaultAuthConfigToken{
+						SecretRef: &secretsv1beta1.VaultSecretReference{Namespace: otherNamespace, Name: "vault-token", Key: "token"},
+					},
+				},
+			},
+			expectedToken: "cross-namespace-token-value",
+		},
+		"no source configured": {
+			authObj: &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{
+					Token: &secretsv1beta1.VaultAuthConfigToken{},
+				},
+			},
+			expectedErr: "no token source configured",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tc.envVars {
+				t.Setenv(k, v)
+			}
+
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(envSecret, crossNamespaceSecret).
+				Build()
+
+			provider := &TokenCredentialProvider{
+				authObj:           tc.authObj,
+				providerNamespace: providerNamespace,
+			}
+
+			token, err := provider.readToken(ctx, fakeClient)
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedToken, token)
+			}
+		})
+	}
+}
+
+func TestTokenCredentialProvider_fileRotation(t *testing.T) {
+	ctx := context.Background()
+
+	tempDir, err := os.MkdirTemp("", "token-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	tokenFile := filepath.Join(tempDir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("initial-token"), 0600))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	provider := &TokenCredentialProvider{}
+	require.NoError(t, provider.Init(ctx, fakeClient, &secretsv1beta1.VaultAuth{
+		Spec: secretsv1beta1.VaultAuthSpec{
+			Method: "token",
+			Token:  &secretsv1beta1.VaultAuthConfigToken{FilePath: tokenFile},
+		},
+	}, "test-provider-namespace"))
+	defer provider.Close()
+
+	initialUID := provider.GetUID()
+	require.Equal(t, tokenUID("initial-token"), initialUID)
+
+	rotated := make(chan struct{}, 1)
+	provider.OnTokenRotated = func(_ context.Context, oldUID, newUID types.UID) {
+		assert.Equal(t, initialUID, oldUID)
+		assert.Equal(t, tokenUID("rotated-token"), newUID)
+		rotated <- struct{}{}
+	}
+
+	// Simulate an atomic-rename replacement: write to a temp file in the
+	// same directory, then rename it over the watched path, as kubelet
+	// projected volumes and Vault Agent's atomic sink both do.
+	tmpFile := tokenFile + ".tmp"
+	require.NoError(t, os.WriteFile(tmpFile, []byte("rotated-token"), 0600))
+	require.NoError(t, os.Rename(tmpFile, tokenFile))
+
+	select {
+	case <-rotated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for token rotation to be detected")
+	}
+
+	assert.Equal(t, tokenUID("rotated-token"), provider.GetUID())
+}
+
+func TestTokenCredentialProvider_handleSecretPoll(t *testing.T) {
+	ctx := context.Background()
+	providerNamespace := "test-provider-namespace"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: providerNamespace},
+		Data:       map[string][]byte{"token": []byte("initial-token")},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	provider := &TokenCredentialProvider{
+		authObj: &secretsv1beta1.VaultAuth{
+			Spec: secretsv1beta1.VaultAuthSpec{
+				Token: &secretsv1beta1.VaultAuthConfigToken{
+					SecretRef: &secretsv1beta1.VaultSecretReference{Name: "vault-token", Key: "token"},
+				},
+			},
+		},
+		providerNamespace: providerNamespace,
+		k8sClient:         fakeClient,
+		uid:               tokenUID("initial-token"),
+	}
+	logger := logr.Discard()
+
+	t.Run("no rotation when content unchanged", func(t *testing.T) {
+		var rotated bool
+		provider.OnTokenRotated = func(context.Context, types.UID, types.UID) { rotated = true }
+
+		provider.handleSecretPoll(ctx, logger)
+		assert.False(t, rotated)
+	})
+
+	t.Run("rotation detected when secret content changes", func(t *testing.T) {
+		secret.Data["token"] = []byte("rotated-token")
+		require.NoError(t, fakeClient.Update(ctx, secret))
+
+		rotated := make(chan struct{}, 1)
+		provider.OnTokenRotated = func(_ context.Context, oldUID, newUID types.UID) {
+			assert.Equal(t, tokenUID("initial-token"), oldUID)
+			assert.Equal(t, tokenUID("rotated-token"), newUID)
+			rotated <- struct{}{}
+		}
+
+		provider.handleSecretPoll(ctx, logger)
+
+		select {
+		case <-rotated:
+		default:
+			t.Fatal("expected OnTokenRotated to be called")
+		}
+		assert.Equal(t, tokenUID("rotated-token"), provider.GetUID())
+	})
+}
+
+func TestTokenCredentialProvider_fileRotation_kubeletSymlinkSwap(t *testing.T) {
+	ctx := context.Background()
+
+	tempDir, err := os.MkdirTemp("", "token-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	// Reproduce kubelet's projected volume layout: the token path is a
+	// symlink into a versioned "..data_1" directory, and rotation swaps the
+	// "..data" symlink to point at a new "..data_2" directory atomically.
+	// fsnotify reports the rename under the "..data" name, never under the
+	// token path itself.
+	dataDir1 := filepath.Join(tempDir, "..data_1")
+	require.NoError(t, os.Mkdir(dataDir1, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir1, "token"), []byte("initial-token"), 0600))
+
+	dataSymlink := filepath.Join(tempDir, "..data")
+	require.NoError(t, os.Symlink(dataDir1, dataSymlink))
+
+	tokenFile := filepath.Join(tempDir, "token")
+	require.NoError(t, os.Symlink(filepath.Join(dataSymlink, "token"), tokenFile))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	provider := &TokenCredentialProvider{}
+	require.NoError(t, provider.Init(ctx, fakeClient, &secretsv1beta1.VaultAuth{
+		Spec: secretsv1beta1.VaultAuthSpec{
+			Method: "token",
+			Token:  &secretsv1beta1.VaultAuthConfigToken{FilePath: tokenFile},
+		},
+	}, "test-provider-namespace"))
+	defer provider.Close()
+
+	initialUID := provider.GetUID()
+	require.Equal(t, tokenUID("initial-token"), initialUID)
+
+	rotated := make(chan struct{}, 1)
+	provider.OnTokenRotated = func(_ context.Context, oldUID, newUID types.UID) {
+		assert.Equal(t, initialUID, oldUID)
+		assert.Equal(t, tokenUID("rotated-token"), newUID)
+		rotated <- struct{}{}
+	}
+
+	dataDir2 := filepath.Join(tempDir, "..data_2")
+	require.NoError(t, os.Mkdir(dataDir2, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir2, "token"), []byte("rotated-token"), 0600))
+
+	dataSymlinkTmp := filepath.Join(tempDir, "..data_tmp")
+	require.NoError(t, os.Symlink(dataDir2, dataSymlinkTmp))
+	require.NoError(t, os.Rename(dataSymlinkTmp, dataSymlink))
+
+	select {
+	case <-rotated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for token rotation to be detected")
+	}
+
+	assert.Equal(t, tokenUID("rotated-token"), provider.GetUID())
+}
+
+func TestApplyVaultConnectionConfig(t *testing.T) {
+	ctx := context.Background()
+	providerNamespace := "test-provider-namespace"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, secretsv1beta1.AddToScheme(scheme))
+
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-ca", Namespace: providerNamespace},
+		Data:       map[string][]byte{"ca.crt": []byte("not-a-real-cert")},
+	}
+
+	tests := map[string]struct {
+		conn        *secretsv1beta1.VaultConnection
+		expectedErr string
+	}{
+		"address only": {
+			conn: &secretsv1beta1.VaultConnection{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: providerNamespace},
+				Spec:       secretsv1beta1.VaultConnectionSpec{Address: "https://vault.example.com:8200"},
+			},
+		},
+		"skip tls verify": {
+			conn: &secretsv1beta1.VaultConnection{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: providerNamespace},
+				Spec: secretsv1beta1.VaultConnectionSpec{
+					Address:       "https://vault.example.com:8200",
+					SkipTLSVerify: true,
+				},
+			},
+		},
+		"missing CA cert secret": {
+			conn: &secretsv1beta1.VaultConnection{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: providerNamespace},
+				Spec:       secretsv1beta1.VaultConnectionSpec{CACertSecretRef: "missing-secret"},
+			},
+			expectedErr: "failed to read VaultConnection CA cert secret",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.conn, caSecret).
+				Build()
+
+			authObj := &secretsv1beta1.VaultAuth{
+				Spec: secretsv1beta1.VaultAuthSpec{VaultConnectionRef: tc.conn.Name},
+			}
+			cfg := vaultapi.DefaultConfig()
+
+			err := applyVaultConnectionConfig(ctx, fakeClient, authObj, providerNamespace, cfg)
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.conn.Spec.Address, cfg.Address)
+		})
+	}
+}
+
+func TestTokenCredentialProvider_renewTick(t *testing.T) {
+	ctx := context.Background()
+
+	tempDir, err := os.MkdirTemp("", "token-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	tokenFile := filepath.Join(tempDir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("s.renewable-token"), 0600))
+
+	provider := &TokenCredentialProvider{
+		authObj: &secretsv1beta1.VaultAuth{
+			Spec: secretsv1beta1.VaultAuthSpec{
+				Token: &secretsv1beta1.VaultAuthConfigToken{FilePath: tokenFile},
+			},
+		},
+	}
+	renew := &secretsv1beta1.VaultTokenRenewSpec{
+		Enabled:   true,
+		Increment: time.Hour,
+		MinTTL:    10 * time.Minute,
+	}
+	logger := logr.Discard()
+
+	t.Run("renews when ttl below MinTTL", func(t *testing.T) {
+		var renewed bool
+		withFakeVaultUnwrapServer(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.Contains(r.URL.Path, "lookup-self"):
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"data": map[string]interface{}{"renewable": true, "ttl": json.Number("60")},
+				})
+			case strings.Contains(r.URL.Path, "renew-self"):
+				renewed = true
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"auth": map[string]interface{}{"client_token": "s.renewable-token"},
+				})
+			default:
+				t.Fatalf("unexpected request to %s", r.URL.Path)
+			}
+		})
+
+		next, keepRenewing := provider.renewTick(ctx, logger, renew, tokenRenewCheckInterval)
+		assert.True(t, renewed)
+		assert.Equal(t, tokenRenewCheckInterval, next)
+		assert.True(t, keepRenewing)
+	})
+
+	t.Run("skips renewal when ttl is healthy", func(t *testing.T) {
+		withFakeVaultUnwrapServer(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Contains(t, r.URL.Path, "lookup-self")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{"renewable": true, "ttl": json.Number("3600")},
+			})
+		})
+
+		next, keepRenewing := provider.renewTick(ctx, logger, renew, tokenRenewCheckInterval)
+		assert.Equal(t, tokenRenewCheckInterval, next)
+		assert.True(t, keepRenewing)
+	})
+
+	t.Run("stops renewing once token is non-renewable", func(t *testing.T) {
+		withFakeVaultUnwrapServer(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Contains(t, r.URL.Path, "lookup-self")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{"renewable": false, "ttl": json.Number("60")},
+			})
+		})
+
+		_, keepRenewing := provider.renewTick(ctx, logger, renew, tokenRenewCheckInterval)
+		assert.False(t, keepRenewing)
+	})
+
+	t.Run("backs off on lookup error", func(t *testing.T) {
+		withFakeVaultUnwrapServer(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+
+		next, keepRenewing := provider.renewTick(ctx, logger, renew, tokenRenewCheckInterval)
+		assert.Equal(t, tokenRenewCheckInterval*2, next)
+		assert.True(t, keepRenewing)
+
+		next, keepRenewing = provider.renewTick(ctx, logger, renew, next)
+		assert.Equal(t, tokenRenewCheckInterval*4, next)
+		assert.True(t, keepRenewing)
+	})
+}
+
+func TestNextRenewBackoff(t *testing.T) {
+	assert.Equal(t, 2*time.Minute, nextRenewBackoff(time.Minute))
+	assert.Equal(t, tokenRenewMaxInterval, nextRenewBackoff(tokenRenewMaxInterval))
+}